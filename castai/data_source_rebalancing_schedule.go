@@ -0,0 +1,152 @@
+package castai
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+)
+
+// dataSourceRebalancingSchedule mirrors resourceRebalancingSchedule's read-only shape so
+// other modules can reference a schedule managed elsewhere without importing it.
+func dataSourceRebalancingSchedule() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRebalancingScheduleRead,
+		Description: "Retrieves an existing rebalancing schedule by name or ID.",
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"schedule":             dataSourceRebalancingScheduleScheduleSchema(),
+			"trigger_conditions":   dataSourceRebalancingScheduleTriggerConditionsSchema(),
+			"launch_configuration": dataSourceRebalancingScheduleLaunchConfigurationSchema(),
+		},
+	}
+}
+
+// The sub-block schemas below mirror resourceRebalancingSchedule's but with every field
+// Computed, since a data source never writes these back to the API.
+func dataSourceRebalancingScheduleScheduleSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"timezone": {Type: schema.TypeString, Computed: true},
+				"window": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"cron":     {Type: schema.TypeString, Computed: true},
+							"duration": {Type: schema.TypeString, Computed: true},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRebalancingScheduleTriggerConditionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"savings_percentage": {Type: schema.TypeFloat, Computed: true},
+				"expression":         {Type: schema.TypeString, Computed: true},
+			},
+		},
+	}
+}
+
+func dataSourceRebalancingScheduleLaunchConfigurationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"node_ttl_seconds":         {Type: schema.TypeInt, Computed: true},
+				"num_targeted_nodes":       {Type: schema.TypeInt, Computed: true},
+				"rebalancing_min_nodes":    {Type: schema.TypeInt, Computed: true},
+				"evict_gracefully":         {Type: schema.TypeBool, Computed: true},
+				"keep_drain_timeout_nodes": {Type: schema.TypeBool, Computed: true},
+				"selector":                 {Type: schema.TypeString, Computed: true},
+				"execution_conditions": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"enabled":                     {Type: schema.TypeBool, Computed: true},
+							"achieved_savings_percentage": {Type: schema.TypeFloat, Computed: true},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRebalancingScheduleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderConfig).api
+
+	id := d.Get("id").(string)
+	name := d.Get("name").(string)
+	if id == "" && name == "" {
+		return diag.Errorf("either \"id\" or \"name\" must be set")
+	}
+
+	var sched *sdk.RebalancingSchedule
+	if id != "" {
+		resp, err := client.RebalancingScheduleAPIGetRebalancingScheduleWithResponse(ctx, id)
+		if err := sdk.CheckOKResponse(resp, err); err != nil {
+			return diag.FromErr(fmt.Errorf("getting rebalancing schedule %q: %w", id, err))
+		}
+		sched = resp.JSON200
+	} else {
+		resp, err := client.RebalancingScheduleAPIListRebalancingSchedulesWithResponse(ctx)
+		if err := sdk.CheckOKResponse(resp, err); err != nil {
+			return diag.FromErr(fmt.Errorf("listing rebalancing schedules: %w", err))
+		}
+		for _, s := range resp.JSON200.Schedules {
+			if s.Name == name {
+				sched = &s
+				break
+			}
+		}
+		if sched == nil {
+			return diag.Errorf("rebalancing schedule with name %q was not found", name)
+		}
+	}
+
+	d.SetId(sched.Id)
+	if err := d.Set("name", sched.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	schedule, err := flattenRebalancingSchedule(sched)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("flattening schedule: %w", err))
+	}
+	if err := d.Set("schedule", schedule); err != nil {
+		return diag.FromErr(fmt.Errorf("setting schedule: %w", err))
+	}
+	if err := d.Set("trigger_conditions", flattenRebalancingTriggerConditions(sched.TriggerConditions)); err != nil {
+		return diag.FromErr(fmt.Errorf("setting trigger_conditions: %w", err))
+	}
+	if err := d.Set("launch_configuration", flattenRebalancingLaunchConfiguration(sched.LaunchConfiguration)); err != nil {
+		return diag.FromErr(fmt.Errorf("setting launch_configuration: %w", err))
+	}
+
+	return nil
+}
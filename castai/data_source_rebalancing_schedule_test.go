@@ -0,0 +1,48 @@
+package castai
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceRebalancingSchedule_basic(t *testing.T) {
+	rName := fmt.Sprintf("%v-rebalancing-schedule-%v", ResourcePrefix, acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: makeDataSourceRebalancingScheduleConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.castai_rebalancing_schedule.by_name", "id",
+						"castai_rebalancing_schedule.test", "id",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.castai_rebalancing_schedule.by_id", "name",
+						"castai_rebalancing_schedule.test", "name",
+					),
+					resource.TestCheckResourceAttr("data.castai_rebalancing_schedule.by_name", "schedule.0.window.0.cron", "5 4 * * *"),
+				),
+			},
+		},
+	})
+}
+
+func makeDataSourceRebalancingScheduleConfig(rName string) string {
+	return makeInitialRebalancingScheduleConfig(rName) + fmt.Sprintf(`
+data "castai_rebalancing_schedule" "by_name" {
+	name = %[1]q
+	depends_on = [castai_rebalancing_schedule.test]
+}
+
+data "castai_rebalancing_schedule" "by_id" {
+	id = castai_rebalancing_schedule.test.id
+}
+`, rName)
+}
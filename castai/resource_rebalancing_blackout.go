@@ -0,0 +1,261 @@
+package castai
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+)
+
+// resourceRebalancingBlackout attaches one or more blackout windows to a rebalancing
+// schedule. Executions that would otherwise fire during a window are suppressed,
+// which covers code-freeze periods and business-hours exclusions.
+func resourceRebalancingBlackout() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRebalancingBlackoutCreate,
+		ReadContext:   resourceRebalancingBlackoutRead,
+		UpdateContext: resourceRebalancingBlackoutUpdate,
+		DeleteContext: resourceRebalancingBlackoutDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceRebalancingBlackoutStateImporter,
+		},
+		Description: "Blackout window(s) during which rebalancing executions for a schedule are suppressed.",
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the blackout window",
+			},
+			"schedule_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the castai_rebalancing_schedule this blackout applies to",
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"timezone": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "UTC",
+				ValidateFunc: validateTimeZone,
+				Description:  "IANA timezone the window's cron expression is evaluated in, e.g. for business-hours exclusions. Defaults to UTC.",
+			},
+			"window": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "One or more windows during which executions are suppressed. Each window is either recurring (cron + duration) or a fixed RFC3339 range (start + end).",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cron": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateCronExpression,
+							Description:  "Cron expression for a recurring blackout window, mutually exclusive with start/end",
+						},
+						"duration": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateGoDuration,
+							Description:  "How long a recurring blackout window stays open after the cron expression fires",
+						},
+						"start": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+							Description:  "RFC3339 start of a fixed blackout window, mutually exclusive with cron/duration",
+						},
+						"end": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+							Description:  "RFC3339 end of a fixed blackout window",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceRebalancingBlackoutCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	req, err := toRebalancingBlackoutUpsertRequest(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := meta.(*ProviderConfig).api
+	resp, err := client.RebalancingScheduleAPICreateRebalancingBlackoutWithResponse(ctx, d.Get("schedule_id").(string), req)
+	if err := sdk.CheckOKResponse(resp, err); err != nil {
+		return diag.FromErr(fmt.Errorf("creating rebalancing blackout: %w", err))
+	}
+
+	d.SetId(resp.JSON200.Id)
+	return resourceRebalancingBlackoutRead(ctx, d, meta)
+}
+
+func resourceRebalancingBlackoutRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderConfig).api
+	resp, err := client.RebalancingScheduleAPIGetRebalancingBlackoutWithResponse(ctx, d.Get("schedule_id").(string), d.Id())
+	if resp != nil && resp.StatusCode() == http.StatusNotFound {
+		d.SetId("")
+		return nil
+	}
+	if err := sdk.CheckOKResponse(resp, err); err != nil {
+		return diag.FromErr(fmt.Errorf("getting rebalancing blackout: %w", err))
+	}
+
+	blackout := resp.JSON200
+	if err := d.Set("name", blackout.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("schedule_id", blackout.ScheduleId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("enabled", blackout.Enabled); err != nil {
+		return diag.FromErr(err)
+	}
+
+	timezone := blackout.Timezone
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	if err := d.Set("timezone", timezone); err != nil {
+		return diag.FromErr(err)
+	}
+	windows, err := flattenRebalancingBlackoutWindows(blackout.Windows, timezone)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("window", windows); err != nil {
+		return diag.FromErr(fmt.Errorf("setting window: %w", err))
+	}
+
+	return nil
+}
+
+func resourceRebalancingBlackoutUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	req, err := toRebalancingBlackoutUpsertRequest(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := meta.(*ProviderConfig).api
+	resp, err := client.RebalancingScheduleAPIUpdateRebalancingBlackoutWithResponse(ctx, d.Get("schedule_id").(string), d.Id(), req)
+	if err := sdk.CheckOKResponse(resp, err); err != nil {
+		return diag.FromErr(fmt.Errorf("updating rebalancing blackout: %w", err))
+	}
+
+	return resourceRebalancingBlackoutRead(ctx, d, meta)
+}
+
+func resourceRebalancingBlackoutDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderConfig).api
+	resp, err := client.RebalancingScheduleAPIDeleteRebalancingBlackoutWithResponse(ctx, d.Get("schedule_id").(string), d.Id())
+	if err := sdk.CheckOKResponse(resp, err); err != nil {
+		return diag.FromErr(fmt.Errorf("deleting rebalancing blackout: %w", err))
+	}
+
+	return nil
+}
+
+// resourceRebalancingBlackoutStateImporter accepts an import ID of the form
+// "<schedule_id>/<blackout_id>", since reading a blackout needs its schedule's ID.
+func resourceRebalancingBlackoutStateImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	scheduleID, blackoutID, ok := strings.Cut(d.Id(), "/")
+	if !ok || scheduleID == "" || blackoutID == "" {
+		return nil, fmt.Errorf("expected import ID in the form <schedule_id>/<blackout_id>, got %q", d.Id())
+	}
+	if err := d.Set("schedule_id", scheduleID); err != nil {
+		return nil, err
+	}
+	d.SetId(blackoutID)
+	return []*schema.ResourceData{d}, nil
+}
+
+func toRebalancingBlackoutUpsertRequest(d *schema.ResourceData) (sdk.RebalancingBlackoutUpsertRequest, error) {
+	timezone := d.Get("timezone").(string)
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return sdk.RebalancingBlackoutUpsertRequest{}, fmt.Errorf("loading blackout timezone: %w", err)
+	}
+
+	var windows []sdk.RebalancingBlackoutWindow
+	for _, w := range d.Get("window").([]interface{}) {
+		window := w.(map[string]interface{})
+		cron := window["cron"].(string)
+		duration := window["duration"].(string)
+		start := window["start"].(string)
+		end := window["end"].(string)
+
+		switch {
+		case (cron != "" || duration != "") && (start != "" || end != ""):
+			return sdk.RebalancingBlackoutUpsertRequest{}, fmt.Errorf("window must set either cron/duration or start/end, not both")
+		case cron != "":
+			utcCron, err := shiftCronTimezone(cron, -cronOffset(loc))
+			if err != nil {
+				return sdk.RebalancingBlackoutUpsertRequest{}, fmt.Errorf("normalizing window cron to UTC: %w", err)
+			}
+			windows = append(windows, sdk.RebalancingBlackoutWindow{
+				Cron:     &utcCron,
+				Duration: stringPtrOrNil(duration),
+			})
+		case start != "":
+			if end == "" {
+				return sdk.RebalancingBlackoutUpsertRequest{}, fmt.Errorf("window with \"start\" must also set \"end\"")
+			}
+			windows = append(windows, sdk.RebalancingBlackoutWindow{
+				Start: &start,
+				End:   &end,
+			})
+		default:
+			return sdk.RebalancingBlackoutUpsertRequest{}, fmt.Errorf("window must set either cron/duration or start/end")
+		}
+	}
+
+	return sdk.RebalancingBlackoutUpsertRequest{
+		Name:     d.Get("name").(string),
+		Enabled:  d.Get("enabled").(bool),
+		Timezone: timezone,
+		Windows:  windows,
+	}, nil
+}
+
+// flattenRebalancingBlackoutWindows converts the API's UTC cron windows back to the
+// blackout's configured timezone, mirroring flattenRebalancingSchedule so the cron the
+// user configured round-trips on Read instead of drifting to UTC.
+func flattenRebalancingBlackoutWindows(windows []sdk.RebalancingBlackoutWindow, timezone string) ([]map[string]interface{}, error) {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("loading blackout timezone %q: %w", timezone, err)
+	}
+
+	out := make([]map[string]interface{}, 0, len(windows))
+	for _, w := range windows {
+		cron := toString(w.Cron)
+		if cron != "" {
+			cron, err = shiftCronTimezone(cron, cronOffset(loc))
+			if err != nil {
+				return nil, fmt.Errorf("converting window cron to %q: %w", timezone, err)
+			}
+		}
+		out = append(out, map[string]interface{}{
+			"cron":     cron,
+			"duration": toString(w.Duration),
+			"start":    toString(w.Start),
+			"end":      toString(w.End),
+		})
+	}
+	return out, nil
+}
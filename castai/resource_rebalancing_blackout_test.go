@@ -0,0 +1,64 @@
+package castai
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceRebalancingBlackout_basic(t *testing.T) {
+	rName := fmt.Sprintf("%v-rebalancing-schedule-%v", ResourcePrefix, acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: makeRebalancingBlackoutConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("castai_rebalancing_blackout.test", "name", rName+"-blackout"),
+					resource.TestCheckResourceAttrPair(
+						"castai_rebalancing_blackout.test", "schedule_id",
+						"castai_rebalancing_schedule.test", "id",
+					),
+					resource.TestCheckResourceAttr("castai_rebalancing_blackout.test", "timezone", "Europe/Vilnius"),
+					resource.TestCheckResourceAttr("castai_rebalancing_blackout.test", "window.0.cron", "0 9 * * 1-5"),
+					resource.TestCheckResourceAttr("castai_rebalancing_blackout.test", "window.1.start", "2026-12-24T00:00:00Z"),
+					resource.TestCheckResourceAttr("castai_rebalancing_blackout.test", "window.1.end", "2027-01-02T00:00:00Z"),
+				),
+			},
+			{
+				ImportState:  true,
+				ResourceName: "castai_rebalancing_blackout.test",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["castai_rebalancing_blackout.test"]
+					return rs.Primary.Attributes["schedule_id"] + "/" + rs.Primary.ID, nil
+				},
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func makeRebalancingBlackoutConfig(rName string) string {
+	return makeInitialRebalancingScheduleConfig(rName) + fmt.Sprintf(`
+resource "castai_rebalancing_blackout" "test" {
+	name        = "%[1]s-blackout"
+	schedule_id = castai_rebalancing_schedule.test.id
+	timezone    = "Europe/Vilnius"
+
+	window {
+		cron     = "0 9 * * 1-5"
+		duration = "8h"
+	}
+	window {
+		start = "2026-12-24T00:00:00Z"
+		end   = "2027-01-02T00:00:00Z"
+	}
+}
+`, rName)
+}
@@ -0,0 +1,208 @@
+package castai
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+)
+
+// terminalRebalancingRunStatuses are the execution statuses that stop resourceRebalancingRunCreate's poll loop.
+var terminalRebalancingRunStatuses = map[string]bool{
+	"Finished":  true,
+	"Failed":    true,
+	"Cancelled": true,
+}
+
+// resourceRebalancingRun triggers a one-shot rebalancing execution against an existing
+// schedule and waits for it to reach a terminal state, surfacing the achieved savings
+// and per-node results so operators can validate schedule economics before enabling the cron.
+func resourceRebalancingRun() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRebalancingRunCreate,
+		ReadContext:   resourceRebalancingRunRead,
+		DeleteContext: resourceRebalancingRunDelete,
+		Description:   "Triggers an ad-hoc rebalancing execution for a castai_rebalancing_schedule, optionally as a dry run.",
+		Schema: map[string]*schema.Schema{
+			"schedule_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the castai_rebalancing_schedule to trigger",
+			},
+			"dry_run": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "When true, the execution reports achievable savings without draining or evicting any nodes",
+			},
+			"execution_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the triggered rebalancing execution",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Terminal status of the execution, e.g. \"Finished\", \"Failed\" or \"Cancelled\"",
+			},
+			"achieved_savings_percentage": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"nodes_affected": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"node_result": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Per-node outcome of the execution",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"node_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"node_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"savings_percentage": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func resourceRebalancingRunCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderConfig).api
+
+	scheduleID := d.Get("schedule_id").(string)
+	createResp, err := client.RebalancingScheduleAPITriggerRebalancingRunWithResponse(ctx, scheduleID, sdk.RebalancingRunTriggerRequest{
+		DryRun: d.Get("dry_run").(bool),
+	})
+	if err := sdk.CheckOKResponse(createResp, err); err != nil {
+		return diag.FromErr(fmt.Errorf("triggering rebalancing run: %w", err))
+	}
+
+	d.SetId(createResp.JSON200.Id)
+
+	run, err := waitForRebalancingRunTerminal(ctx, d, client, scheduleID, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if run.Status == "Failed" {
+		return diag.Errorf("rebalancing run %q failed", d.Id())
+	}
+
+	return setRebalancingRunFields(d, run)
+}
+
+func waitForRebalancingRunTerminal(ctx context.Context, d *schema.ResourceData, client sdk.ClientInterface, scheduleID, runID string) (*sdk.RebalancingRun, error) {
+	var run *sdk.RebalancingRun
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		resp, err := client.RebalancingScheduleAPIGetRebalancingRunWithResponse(ctx, scheduleID, runID)
+		if err := sdk.CheckOKResponse(resp, err); err != nil {
+			return resource.NonRetryableError(fmt.Errorf("getting rebalancing run: %w", err))
+		}
+
+		if !terminalRebalancingRunStatuses[resp.JSON200.Status] {
+			return resource.RetryableError(fmt.Errorf("rebalancing run %q is still %q", runID, resp.JSON200.Status))
+		}
+		run = resp.JSON200
+		return nil
+	})
+	return run, err
+}
+
+func resourceRebalancingRunRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderConfig).api
+	resp, err := client.RebalancingScheduleAPIGetRebalancingRunWithResponse(ctx, d.Get("schedule_id").(string), d.Id())
+	if resp != nil && resp.StatusCode() == http.StatusNotFound {
+		d.SetId("")
+		return nil
+	}
+	if err := sdk.CheckOKResponse(resp, err); err != nil {
+		return diag.FromErr(fmt.Errorf("getting rebalancing run: %w", err))
+	}
+
+	return setRebalancingRunFields(d, resp.JSON200)
+}
+
+func setRebalancingRunFields(d *schema.ResourceData, run *sdk.RebalancingRun) diag.Diagnostics {
+	if err := d.Set("execution_id", run.Id); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("status", run.Status); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("achieved_savings_percentage", run.AchievedSavingsPercentage); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("nodes_affected", run.NodesAffected); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("node_result", flattenRebalancingRunNodeResults(run.NodeResults)); err != nil {
+		return diag.FromErr(fmt.Errorf("setting node_result: %w", err))
+	}
+
+	return nil
+}
+
+// resourceRebalancingRunDelete cancels the execution if it's still in-flight; a run
+// that already reached a terminal state has nothing left to undo, so this is a no-op.
+func resourceRebalancingRunDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderConfig).api
+	scheduleID := d.Get("schedule_id").(string)
+
+	resp, err := client.RebalancingScheduleAPIGetRebalancingRunWithResponse(ctx, scheduleID, d.Id())
+	if resp != nil && resp.StatusCode() == http.StatusNotFound {
+		return nil
+	}
+	if err := sdk.CheckOKResponse(resp, err); err != nil {
+		return diag.FromErr(fmt.Errorf("getting rebalancing run: %w", err))
+	}
+	if terminalRebalancingRunStatuses[resp.JSON200.Status] {
+		return nil
+	}
+
+	cancelResp, err := client.RebalancingScheduleAPICancelRebalancingRunWithResponse(ctx, scheduleID, d.Id())
+	if err := sdk.CheckOKResponse(cancelResp, err); err != nil {
+		return diag.FromErr(fmt.Errorf("cancelling rebalancing run: %w", err))
+	}
+
+	return nil
+}
+
+func flattenRebalancingRunNodeResults(results []sdk.RebalancingRunNodeResult) []map[string]interface{} {
+	out := make([]map[string]interface{}, 0, len(results))
+	for _, r := range results {
+		out = append(out, map[string]interface{}{
+			"node_id":            r.NodeId,
+			"node_name":          r.NodeName,
+			"status":             r.Status,
+			"savings_percentage": r.SavingsPercentage,
+		})
+	}
+	return out
+}
@@ -0,0 +1,43 @@
+package castai
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceRebalancingRun_dryRun(t *testing.T) {
+	rName := fmt.Sprintf("%v-rebalancing-schedule-%v", ResourcePrefix, acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: makeRebalancingRunConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"castai_rebalancing_run.test", "schedule_id",
+						"castai_rebalancing_schedule.test", "id",
+					),
+					resource.TestCheckResourceAttr("castai_rebalancing_run.test", "dry_run", "true"),
+					resource.TestCheckResourceAttrSet("castai_rebalancing_run.test", "execution_id"),
+					resource.TestCheckResourceAttrSet("castai_rebalancing_run.test", "status"),
+					resource.TestCheckResourceAttrSet("castai_rebalancing_run.test", "achieved_savings_percentage"),
+				),
+			},
+		},
+	})
+}
+
+func makeRebalancingRunConfig(rName string) string {
+	return makeInitialRebalancingScheduleConfig(rName) + `
+resource "castai_rebalancing_run" "test" {
+	schedule_id = castai_rebalancing_schedule.test.id
+	dry_run     = true
+}
+`
+}
@@ -0,0 +1,594 @@
+package castai
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/robfig/cron/v3"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+)
+
+// cronParser validates cron expressions using the standard 5-field spec
+// (minute hour dom month dow), matching what the CAST AI API accepts.
+var cronParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+
+func resourceRebalancingSchedule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRebalancingScheduleCreate,
+		ReadContext:   resourceRebalancingScheduleRead,
+		UpdateContext: resourceRebalancingScheduleUpdate,
+		DeleteContext: resourceRebalancingScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceRebalancingScheduleStateImporter,
+		},
+		Description: "Rebalancing schedule resource allows configuring recurring rebalancing of a cluster.",
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the schedule",
+			},
+			"schedule": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "Schedule for cluster rebalancing",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"timezone": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "UTC",
+							ValidateFunc: validateTimeZone,
+							Description:  "IANA time zone name used to interpret every window's cron expression, e.g. \"Europe/Vilnius\". Windows are normalized to UTC before being sent to the CAST AI API, which is timezone-naive.",
+						},
+						"window": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Description: "One or more cron-triggered windows during which rebalancing is allowed to run",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cron": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateCronExpression,
+										Description:  "Cron expression, in the standard 5-field format, interpreted in the schedule's timezone",
+									},
+									"duration": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateGoDuration,
+										Description:  "How long the window stays open after the cron expression fires, e.g. \"30m\". Defaults to the API's own window length when omitted.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"trigger_conditions": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"savings_percentage": {
+							Type:     schema.TypeFloat,
+							Optional: true,
+						},
+						"expression": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateTriggerConditionsExpression,
+							Description: "CEL expression evaluated against the rebalancing input object for composite triggers not covered by the scalar fields above, " +
+								"e.g. \"savings_percentage > 10 && time.hour >= 22\". Available fields are documented at " +
+								"https://docs.cast.ai/docs/rebalancing-schedules#trigger-expressions: savings_percentage, idle_node_count, spot_interruption_rate, " +
+								"cluster.node_count and time.hour.",
+						},
+					},
+				},
+			},
+			"launch_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"node_ttl_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"num_targeted_nodes": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"rebalancing_min_nodes": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"evict_gracefully": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"keep_drain_timeout_nodes": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"selector": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"execution_conditions": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+									"achieved_savings_percentage": {
+										Type:     schema.TypeFloat,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// validateCronExpression rejects cron expressions the API would reject at apply time,
+// so plan-time feedback points directly at the bad field.
+func validateCronExpression(v interface{}, path string) (ws []string, errs []error) {
+	value := v.(string)
+	if _, err := cronParser.Parse(value); err != nil {
+		errs = append(errs, fmt.Errorf("%q is not a valid cron expression: %w", path, err))
+	}
+	return
+}
+
+// validateTimeZone ensures the configured timezone is resolvable, so a typo like
+// "Europe/Vilnus" fails during plan rather than surfacing as an opaque API error.
+func validateTimeZone(v interface{}, path string) (ws []string, errs []error) {
+	value := v.(string)
+	if _, err := time.LoadLocation(value); err != nil {
+		errs = append(errs, fmt.Errorf("%q is not a valid IANA time zone: %w", path, err))
+	}
+	return
+}
+
+// triggerConditionsCELEnv declares the input object made available to trigger_conditions.expression.
+// Field names and nesting must stay in sync with the documented rebalancing input schema.
+var triggerConditionsCELEnv = mustNewTriggerConditionsCELEnv()
+
+func mustNewTriggerConditionsCELEnv() *cel.Env {
+	env, err := cel.NewEnv(
+		cel.Variable("savings_percentage", cel.DoubleType),
+		cel.Variable("idle_node_count", cel.IntType),
+		cel.Variable("spot_interruption_rate", cel.DoubleType),
+		cel.Variable("cluster", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("time", cel.MapType(cel.StringType, cel.DynType)),
+	)
+	if err != nil {
+		panic(fmt.Errorf("building trigger_conditions CEL environment: %w", err))
+	}
+	return env
+}
+
+// validateTriggerConditionsExpression compiles the CEL expression at plan time so a
+// typo like "saving_percentage" fails before apply, with the diagnostic pointing at
+// the offending token instead of surfacing as an opaque API error.
+func validateTriggerConditionsExpression(v interface{}, path string) (ws []string, errs []error) {
+	value := v.(string)
+	if value == "" {
+		return
+	}
+	_, issues := triggerConditionsCELEnv.Compile(value)
+	if issues != nil && issues.Err() != nil {
+		errs = append(errs, fmt.Errorf("%q is not a valid CEL expression: %w", path, issues.Err()))
+	}
+	return
+}
+
+func validateGoDuration(v interface{}, path string) (ws []string, errs []error) {
+	value := v.(string)
+	if value == "" {
+		return
+	}
+	if _, err := time.ParseDuration(value); err != nil {
+		errs = append(errs, fmt.Errorf("%q is not a valid duration: %w", path, err))
+	}
+	return
+}
+
+func resourceRebalancingScheduleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	req, err := toRebalancingScheduleUpsertRequest(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := meta.(*ProviderConfig).api
+	resp, err := client.RebalancingScheduleAPICreateRebalancingScheduleWithResponse(ctx, req)
+	if err := sdk.CheckOKResponse(resp, err); err != nil {
+		return diag.FromErr(fmt.Errorf("creating rebalancing schedule: %w", err))
+	}
+
+	d.SetId(resp.JSON200.Id)
+	return resourceRebalancingScheduleRead(ctx, d, meta)
+}
+
+func resourceRebalancingScheduleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderConfig).api
+	resp, err := client.RebalancingScheduleAPIGetRebalancingScheduleWithResponse(ctx, d.Id())
+	if resp != nil && resp.StatusCode() == http.StatusNotFound {
+		d.SetId("")
+		return nil
+	}
+	if err := sdk.CheckOKResponse(resp, err); err != nil {
+		return diag.FromErr(fmt.Errorf("getting rebalancing schedule: %w", err))
+	}
+
+	sched := resp.JSON200
+	if err := d.Set("name", sched.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	schedule, err := flattenRebalancingSchedule(sched)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("flattening schedule: %w", err))
+	}
+	if err := d.Set("schedule", schedule); err != nil {
+		return diag.FromErr(fmt.Errorf("setting schedule: %w", err))
+	}
+	if err := d.Set("trigger_conditions", flattenRebalancingTriggerConditions(sched.TriggerConditions)); err != nil {
+		return diag.FromErr(fmt.Errorf("setting trigger_conditions: %w", err))
+	}
+	if err := d.Set("launch_configuration", flattenRebalancingLaunchConfiguration(sched.LaunchConfiguration)); err != nil {
+		return diag.FromErr(fmt.Errorf("setting launch_configuration: %w", err))
+	}
+
+	return nil
+}
+
+func resourceRebalancingScheduleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	req, err := toRebalancingScheduleUpsertRequest(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := meta.(*ProviderConfig).api
+	resp, err := client.RebalancingScheduleAPIUpdateRebalancingScheduleWithResponse(ctx, d.Id(), req)
+	if err := sdk.CheckOKResponse(resp, err); err != nil {
+		return diag.FromErr(fmt.Errorf("updating rebalancing schedule: %w", err))
+	}
+
+	return resourceRebalancingScheduleRead(ctx, d, meta)
+}
+
+func resourceRebalancingScheduleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderConfig).api
+	resp, err := client.RebalancingScheduleAPIDeleteRebalancingScheduleWithResponse(ctx, d.Id())
+	if err := sdk.CheckOKResponse(resp, err); err != nil {
+		return diag.FromErr(fmt.Errorf("deleting rebalancing schedule: %w", err))
+	}
+
+	return nil
+}
+
+func resourceRebalancingScheduleStateImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*ProviderConfig).api
+	id := d.Id()
+
+	// Allow import by name in addition to ID: a lookup by ID that comes back without a
+	// JSON200 body (a 404, or a transport error) is treated as "id is actually a name".
+	getResp, getErr := client.RebalancingScheduleAPIGetRebalancingScheduleWithResponse(ctx, id)
+	if getErr != nil || getResp.JSON200 == nil {
+		resp, findErr := client.RebalancingScheduleAPIListRebalancingSchedulesWithResponse(ctx)
+		if findErr != nil {
+			return nil, fmt.Errorf("looking up rebalancing schedule %q: %w", id, findErr)
+		}
+		for _, s := range resp.JSON200.Schedules {
+			if s.Name == id {
+				d.SetId(s.Id)
+				break
+			}
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// toRebalancingScheduleUpsertRequest builds the API request, normalizing every window's
+// cron expression from the configured timezone to UTC since the API only ever triggers
+// in UTC. The configured timezone is also sent along so a later Read can convert the
+// UTC windows the API returns back to the value the user actually configured.
+func toRebalancingScheduleUpsertRequest(d *schema.ResourceData) (sdk.RebalancingScheduleUpsertRequest, error) {
+	scheduleCfg := d.Get("schedule").([]interface{})[0].(map[string]interface{})
+	timezone := scheduleCfg["timezone"].(string)
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return sdk.RebalancingScheduleUpsertRequest{}, fmt.Errorf("loading schedule timezone: %w", err)
+	}
+
+	var windows []sdk.RebalancingScheduleWindow
+	for _, w := range scheduleCfg["window"].([]interface{}) {
+		window := w.(map[string]interface{})
+		utcCron, err := shiftCronTimezone(window["cron"].(string), -cronOffset(loc))
+		if err != nil {
+			return sdk.RebalancingScheduleUpsertRequest{}, fmt.Errorf("normalizing window cron to UTC: %w", err)
+		}
+		windows = append(windows, sdk.RebalancingScheduleWindow{
+			Cron:     utcCron,
+			Duration: stringPtrOrNil(window["duration"].(string)),
+		})
+	}
+
+	triggerCfg := d.Get("trigger_conditions").([]interface{})[0].(map[string]interface{})
+	launchCfg := d.Get("launch_configuration").([]interface{})[0].(map[string]interface{})
+
+	return sdk.RebalancingScheduleUpsertRequest{
+		Name:                d.Get("name").(string),
+		Timezone:            timezone,
+		Windows:             windows,
+		TriggerConditions:   toRebalancingTriggerConditions(triggerCfg),
+		LaunchConfiguration: toRebalancingLaunchConfiguration(launchCfg),
+	}, nil
+}
+
+// cronOffsetReferenceInstant is a fixed instant used to resolve a timezone's UTC
+// offset, instead of time.Now(). Resolving against "now" would make the shift depend
+// on when Terraform happens to run: a DST-observing zone would resolve a different
+// offset in summer than in winter, so the same config would produce a different UTC
+// cron (and a perpetual diff, or a schedule silently firing an hour off) purely
+// depending on apply/refresh timing. Every window is instead resolved once against
+// this fixed reference, independent of when it will actually fire.
+var cronOffsetReferenceInstant = time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+
+func cronOffset(loc *time.Location) time.Duration {
+	_, offsetSeconds := cronOffsetReferenceInstant.In(loc).Zone()
+	return time.Duration(offsetSeconds) * time.Second
+}
+
+// shiftCronTimezone rewrites a cron expression's minute/hour fields by offset, so it
+// fires at the same wall-clock moment as it would with the opposite offset applied.
+// If the shift would move the expression onto a different calendar day, the
+// day-of-month and day-of-week fields must both be "*" — otherwise the shifted
+// expression would fire on the wrong day, so this is rejected rather than silently
+// producing a wrong schedule.
+func shiftCronTimezone(cronExpr string, offset time.Duration) (string, error) {
+	if offset == 0 {
+		return cronExpr, nil
+	}
+	if _, err := cronParser.Parse(cronExpr); err != nil {
+		return "", err
+	}
+
+	fields := strings.Fields(cronExpr)
+	minuteField, hourField, domField, dowField := fields[0], fields[1], fields[2], fields[4]
+
+	offsetMinutes := int(offset / time.Minute)
+	wholeHours, remainderMinutes := offsetMinutes/60, offsetMinutes%60
+
+	var (
+		shiftedMinute, shiftedHour string
+		crossesDay                 bool
+		err                        error
+	)
+	if remainderMinutes == 0 {
+		shiftedMinute, shiftedHour, crossesDay, err = shiftWholeHours(minuteField, hourField, wholeHours)
+	} else {
+		shiftedMinute, shiftedHour, crossesDay, err = shiftSingleTime(minuteField, hourField, offset)
+	}
+	if err != nil {
+		return "", fmt.Errorf("shifting cron expression %q for timezone: %w", cronExpr, err)
+	}
+	if crossesDay && (domField != "*" || dowField != "*") {
+		return "", fmt.Errorf("cron expression %q crosses a day boundary once shifted for its timezone; "+
+			"day-of-month and day-of-week must both be \"*\" for windows outside UTC", cronExpr)
+	}
+
+	fields[0], fields[1] = shiftedMinute, shiftedHour
+	return strings.Join(fields, " "), nil
+}
+
+// shiftWholeHours shifts only the hour field by a whole number of hours. The minute
+// field is left untouched — and so may safely be "*", a list, or a range — because a
+// whole-hour shift never changes which minute a window fires on.
+func shiftWholeHours(minuteField, hourField string, hours int) (minute, hour string, crossesDay bool, err error) {
+	if hourField == "*" {
+		return minuteField, hourField, false, nil
+	}
+
+	values, err := parseCronIntField(hourField)
+	if err != nil {
+		return "", "", false, fmt.Errorf("hour field %q: %w", hourField, err)
+	}
+
+	shifted := make([]int, len(values))
+	for i, h := range values {
+		s := h + hours
+		if s < 0 || s >= 24 {
+			crossesDay = true
+		}
+		shifted[i] = ((s % 24) + 24) % 24
+	}
+	return minuteField, joinCronIntField(shifted), crossesDay, nil
+}
+
+// shiftSingleTime shifts one concrete minute/hour pair by offset. It's the only safe
+// path for a fractional-hour timezone offset (e.g. Asia/Kolkata, UTC+5:30): shifting a
+// minute field with more than one value by a non-whole-hour amount would carry into
+// the hour field differently per minute, which a 5-field cron expression can't
+// represent, so any such field is rejected instead.
+func shiftSingleTime(minuteField, hourField string, offset time.Duration) (minute, hour string, crossesDay bool, err error) {
+	m, errM := strconv.Atoi(minuteField)
+	h, errH := strconv.Atoi(hourField)
+	if errM != nil || errH != nil {
+		return "", "", false, fmt.Errorf("minute field %q and hour field %q must each be a single value to shift "+
+			"by a fractional-hour timezone offset", minuteField, hourField)
+	}
+
+	base := time.Date(2000, 1, 1, h, m, 0, 0, time.UTC)
+	shifted := base.Add(offset)
+	return strconv.Itoa(shifted.Minute()), strconv.Itoa(shifted.Hour()), shifted.Day() != base.Day(), nil
+}
+
+// parseCronIntField expands a cron field into its individual integer values. It
+// accepts a single integer, a comma-separated list, and simple a-b ranges; step
+// syntax ("*/n", "a-b/n") is rejected since the set of values it matches is not
+// generally preserved by a shift.
+func parseCronIntField(field string) ([]int, error) {
+	if strings.Contains(field, "/") {
+		return nil, fmt.Errorf("step syntax is not supported for a non-UTC timezone; rewrite without \"/\" or use timezone = \"UTC\"")
+	}
+
+	var values []int
+	for _, part := range strings.Split(field, ",") {
+		if lo, hi, ok := strings.Cut(part, "-"); ok {
+			loN, err := strconv.Atoi(lo)
+			if err != nil {
+				return nil, fmt.Errorf("invalid range %q", part)
+			}
+			hiN, err := strconv.Atoi(hi)
+			if err != nil {
+				return nil, fmt.Errorf("invalid range %q", part)
+			}
+			for v := loN; v <= hiN; v++ {
+				values = append(values, v)
+			}
+			continue
+		}
+		v, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %q", part)
+		}
+		values = append(values, v)
+	}
+	return values, nil
+}
+
+func joinCronIntField(values []int) string {
+	sort.Ints(values)
+	strs := make([]string, len(values))
+	for i, v := range values {
+		strs[i] = strconv.Itoa(v)
+	}
+	return strings.Join(strs, ",")
+}
+
+func stringPtrOrNil(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// flattenRebalancingSchedule converts the API's UTC windows back to the schedule's
+// configured timezone, so schedule.0.window.N.cron round-trips to what the user wrote
+// rather than the UTC-shifted value actually sent to the API.
+func flattenRebalancingSchedule(sched *sdk.RebalancingSchedule) ([]map[string]interface{}, error) {
+	timezone := sched.Timezone
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("loading schedule timezone %q: %w", timezone, err)
+	}
+
+	windows := make([]map[string]interface{}, 0, len(sched.Windows))
+	for _, w := range sched.Windows {
+		localCron, err := shiftCronTimezone(w.Cron, cronOffset(loc))
+		if err != nil {
+			return nil, fmt.Errorf("converting window cron to %q: %w", timezone, err)
+		}
+		windows = append(windows, map[string]interface{}{
+			"cron":     localCron,
+			"duration": toString(w.Duration),
+		})
+	}
+
+	return []map[string]interface{}{
+		{
+			"timezone": timezone,
+			"window":   windows,
+		},
+	}, nil
+}
+
+func flattenRebalancingTriggerConditions(tc sdk.RebalancingTriggerConditions) []map[string]interface{} {
+	return []map[string]interface{}{
+		{
+			"savings_percentage": tc.SavingsPercentage,
+			"expression":         toString(tc.Expression),
+		},
+	}
+}
+
+func toRebalancingTriggerConditions(cfg map[string]interface{}) sdk.RebalancingTriggerConditions {
+	return sdk.RebalancingTriggerConditions{
+		SavingsPercentage: cfg["savings_percentage"].(float64),
+		Expression:        stringPtrOrNil(cfg["expression"].(string)),
+	}
+}
+
+func flattenRebalancingLaunchConfiguration(lc sdk.RebalancingLaunchConfiguration) []map[string]interface{} {
+	out := map[string]interface{}{
+		"node_ttl_seconds":         lc.NodeTtlSeconds,
+		"num_targeted_nodes":       lc.NumTargetedNodes,
+		"rebalancing_min_nodes":    lc.RebalancingMinNodes,
+		"evict_gracefully":         lc.EvictGracefully,
+		"keep_drain_timeout_nodes": lc.KeepDrainTimeoutNodes,
+		"selector":                 toString(lc.Selector),
+	}
+	if lc.ExecutionConditions != nil {
+		out["execution_conditions"] = []map[string]interface{}{
+			{
+				"enabled":                     lc.ExecutionConditions.Enabled,
+				"achieved_savings_percentage": lc.ExecutionConditions.AchievedSavingsPercentage,
+			},
+		}
+	}
+	return []map[string]interface{}{out}
+}
+
+func toRebalancingLaunchConfiguration(cfg map[string]interface{}) sdk.RebalancingLaunchConfiguration {
+	lc := sdk.RebalancingLaunchConfiguration{
+		NodeTtlSeconds:        cfg["node_ttl_seconds"].(int),
+		NumTargetedNodes:      cfg["num_targeted_nodes"].(int),
+		RebalancingMinNodes:   cfg["rebalancing_min_nodes"].(int),
+		EvictGracefully:       cfg["evict_gracefully"].(bool),
+		KeepDrainTimeoutNodes: cfg["keep_drain_timeout_nodes"].(bool),
+		Selector:              stringPtrOrNil(cfg["selector"].(string)),
+	}
+	if ec, ok := cfg["execution_conditions"].([]interface{}); ok && len(ec) > 0 {
+		execCfg := ec[0].(map[string]interface{})
+		lc.ExecutionConditions = &sdk.RebalancingExecutionConditions{
+			Enabled:                   execCfg["enabled"].(bool),
+			AchievedSavingsPercentage: execCfg["achieved_savings_percentage"].(float64),
+		}
+	}
+	return lc
+}
+
+func toString(v *string) string {
+	if v == nil {
+		return ""
+	}
+	return *v
+}
@@ -2,6 +2,7 @@ package castai
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -20,7 +21,8 @@ func TestAccResourceRebalancingSchedule_basic(t *testing.T) {
 				Config: makeInitialRebalancingScheduleConfig(rName),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("castai_rebalancing_schedule.test", "name", rName),
-					resource.TestCheckResourceAttr("castai_rebalancing_schedule.test", "schedule.0.cron", "5 4 * * *"),
+					resource.TestCheckResourceAttr("castai_rebalancing_schedule.test", "schedule.0.timezone", "UTC"),
+					resource.TestCheckResourceAttr("castai_rebalancing_schedule.test", "schedule.0.window.0.cron", "5 4 * * *"),
 				),
 			},
 			{
@@ -41,19 +43,77 @@ func TestAccResourceRebalancingSchedule_basic(t *testing.T) {
 				Config: makeUpdatedRebalancingScheduleConfig(rName + " renamed"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("castai_rebalancing_schedule.test", "name", rName+" renamed"),
-					resource.TestCheckResourceAttr("castai_rebalancing_schedule.test", "schedule.0.cron", "1 4 * * *"),
+					resource.TestCheckResourceAttr("castai_rebalancing_schedule.test", "schedule.0.timezone", "Europe/Vilnius"),
+					resource.TestCheckResourceAttr("castai_rebalancing_schedule.test", "schedule.0.window.0.cron", "1 4 * * *"),
+					resource.TestCheckResourceAttr("castai_rebalancing_schedule.test", "schedule.0.window.1.cron", "0 12 * * *"),
+					resource.TestCheckResourceAttr("castai_rebalancing_schedule.test", "schedule.0.window.1.duration", "45m"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccResourceRebalancingSchedule_triggerExpression(t *testing.T) {
+	rName := fmt.Sprintf("%v-rebalancing-schedule-%v", ResourcePrefix, acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: makeRebalancingScheduleTriggerExpressionConfig(rName, `savings_percentage > 10 && time.hour >= 22`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("castai_rebalancing_schedule.test", "name", rName),
+					resource.TestCheckResourceAttr("castai_rebalancing_schedule.test", "trigger_conditions.0.expression", `savings_percentage > 10 && time.hour >= 22`),
+				),
+			},
+			{
+				// import by ID
+				ImportState:       true,
+				ResourceName:      "castai_rebalancing_schedule.test",
+				ImportStateVerify: true,
+			},
+			{
+				Config:      makeRebalancingScheduleTriggerExpressionConfig(rName, `savings_percentage >`),
+				ExpectError: regexp.MustCompile(`is not a valid CEL expression`),
+			},
+		},
+	})
+}
+
+func makeRebalancingScheduleTriggerExpressionConfig(rName, expression string) string {
+	template := `
+resource "castai_rebalancing_schedule" "test" {
+	name = %q
+	schedule {
+		window {
+			cron = "5 4 * * *"
+		}
+	}
+	trigger_conditions {
+		expression = %q
+	}
+	launch_configuration {
+		execution_conditions {
+			enabled = false
+			achieved_savings_percentage = 0
+		}
+		keep_drain_timeout_nodes = true
+	}
+}
+`
+	return fmt.Sprintf(template, rName, expression)
+}
+
 func makeInitialRebalancingScheduleConfig(rName string) string {
 	template := `
 resource "castai_rebalancing_schedule" "test" {
 	name = %q
 	schedule {
-		cron = "5 4 * * *"
+		window {
+			cron = "5 4 * * *"
+		}
 	}
 	trigger_conditions {
 		savings_percentage = 15.25
@@ -75,7 +135,14 @@ func makeUpdatedRebalancingScheduleConfig(rName string) string {
 resource "castai_rebalancing_schedule" "test" {
 	name = %q
 	schedule {
-		cron = "1 4 * * *"
+		timezone = "Europe/Vilnius"
+		window {
+			cron = "1 4 * * *"
+		}
+		window {
+			cron = "0 12 * * *"
+			duration = "45m"
+		}
 	}
 	trigger_conditions {
 		savings_percentage = 1.23456